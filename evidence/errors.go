@@ -0,0 +1,21 @@
+package evidence
+
+import "fmt"
+
+// ErrDatabase wraps errors coming from the evidence store's underlying
+// database.
+type ErrDatabase struct {
+	Err error
+}
+
+func (err ErrDatabase) Error() string {
+	return fmt.Sprintf("database error: %v", err.Err)
+}
+
+// ErrEvidenceAlreadyStored is returned by Pool.AddEvidence when the
+// evidence is already stored as pending evidence.
+type ErrEvidenceAlreadyStored struct{}
+
+func (err ErrEvidenceAlreadyStored) Error() string {
+	return "evidence already stored"
+}
@@ -0,0 +1,194 @@
+package evidence
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+
+	sm "github.com/tendermint/tendermint/state"
+	"github.com/tendermint/tendermint/types"
+)
+
+// EvidenceHandler lets an application teach the Pool how to verify,
+// prioritize and (for composite evidence) split a concrete types.Evidence
+// implementation, without the Pool needing to know about that evidence kind
+// itself. Applications register a handler for their own evidence kinds
+// (e.g. ABCI-level misbehaviour or app-specific fraud proofs) with
+// RegisterEvidenceHandler the same way the built-in kinds are registered
+// below.
+type EvidenceHandler interface {
+	// Verify checks the evidence against state and the validator set at the
+	// evidence's height. header is the block header at that height where
+	// the Pool loads one (composite evidence and LunaticValidatorEvidence)
+	// and nil otherwise.
+	Verify(state sm.State, valSet *types.ValidatorSet, header *types.Header) error
+
+	// Priority returns the priority stored with this evidence in the Store,
+	// given the validator set at the evidence's height. Neither
+	// PendingEvidence nor the Pool's evidenceList is ordered by it.
+	Priority(valSet *types.ValidatorSet) int64
+
+	// Split breaks composite evidence (e.g. ConflictingHeadersEvidence)
+	// into the individual pieces of evidence it proves. Handlers for
+	// non-composite evidence kinds return a single-element slice
+	// containing the evidence unchanged.
+	Split(header *types.Header, valSet *types.ValidatorSet, valToLastHeight valToLastHeightMap) []types.Evidence
+}
+
+// evidenceHandlerFactory builds an EvidenceHandler bound to a specific
+// piece of evidence.
+type evidenceHandlerFactory func(types.Evidence) EvidenceHandler
+
+var (
+	handlerRegistryMtx sync.RWMutex
+	handlerRegistry    = make(map[reflect.Type]evidenceHandlerFactory)
+)
+
+// RegisterEvidenceHandler registers the factory used to build an
+// EvidenceHandler for every types.Evidence value whose concrete type
+// matches that of sample. Typically called from an init function.
+func RegisterEvidenceHandler(sample types.Evidence, factory evidenceHandlerFactory) {
+	handlerRegistryMtx.Lock()
+	defer handlerRegistryMtx.Unlock()
+	handlerRegistry[reflect.TypeOf(sample)] = factory
+}
+
+// evidenceHandlerFor looks up and constructs the EvidenceHandler registered
+// for ev's concrete type.
+func evidenceHandlerFor(ev types.Evidence) (EvidenceHandler, error) {
+	handlerRegistryMtx.RLock()
+	factory, ok := handlerRegistry[reflect.TypeOf(ev)]
+	handlerRegistryMtx.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("no EvidenceHandler registered for evidence type %T", ev)
+	}
+	return factory(ev), nil
+}
+
+func init() {
+	RegisterEvidenceHandler(&types.DuplicateVoteEvidence{}, newDuplicateVoteEvidenceHandler)
+	RegisterEvidenceHandler(&types.LunaticValidatorEvidence{}, newLunaticValidatorEvidenceHandler)
+	RegisterEvidenceHandler(&types.PhantomValidatorEvidence{}, newPhantomValidatorEvidenceHandler)
+	RegisterEvidenceHandler(&types.ConflictingHeadersEvidence{}, newConflictingHeadersEvidenceHandler)
+	RegisterEvidenceHandler(&types.AmnesiaEvidence{}, newAmnesiaEvidenceHandler)
+}
+
+// votingPowerPriority is embedded by handlers that use the default priority
+// scheme: the accused validator's voting power at the evidence's height.
+type votingPowerPriority struct {
+	ev types.Evidence
+}
+
+func (p votingPowerPriority) Priority(valSet *types.ValidatorSet) int64 {
+	_, val := valSet.GetByAddress(p.ev.Address())
+	return val.VotingPower
+}
+
+type duplicateVoteEvidenceHandler struct {
+	votingPowerPriority
+	ev *types.DuplicateVoteEvidence
+}
+
+func newDuplicateVoteEvidenceHandler(ev types.Evidence) EvidenceHandler {
+	dve := ev.(*types.DuplicateVoteEvidence)
+	return &duplicateVoteEvidenceHandler{votingPowerPriority{dve}, dve}
+}
+
+func (h *duplicateVoteEvidenceHandler) Verify(state sm.State, valSet *types.ValidatorSet, header *types.Header) error {
+	return sm.VerifyEvidence(state, h.ev, valSet, header)
+}
+
+func (h *duplicateVoteEvidenceHandler) Split(
+	_ *types.Header, _ *types.ValidatorSet, _ valToLastHeightMap,
+) []types.Evidence {
+	return []types.Evidence{h.ev}
+}
+
+type lunaticValidatorEvidenceHandler struct {
+	votingPowerPriority
+	ev *types.LunaticValidatorEvidence
+}
+
+func newLunaticValidatorEvidenceHandler(ev types.Evidence) EvidenceHandler {
+	lve := ev.(*types.LunaticValidatorEvidence)
+	return &lunaticValidatorEvidenceHandler{votingPowerPriority{lve}, lve}
+}
+
+// Verify passes header on to sm.VerifyEvidence, which does not check the
+// evidence against it.
+func (h *lunaticValidatorEvidenceHandler) Verify(state sm.State, valSet *types.ValidatorSet, header *types.Header) error {
+	return sm.VerifyEvidence(state, h.ev, valSet, header)
+}
+
+func (h *lunaticValidatorEvidenceHandler) Split(
+	_ *types.Header, _ *types.ValidatorSet, _ valToLastHeightMap,
+) []types.Evidence {
+	return []types.Evidence{h.ev}
+}
+
+type phantomValidatorEvidenceHandler struct {
+	votingPowerPriority
+	ev *types.PhantomValidatorEvidence
+}
+
+func newPhantomValidatorEvidenceHandler(ev types.Evidence) EvidenceHandler {
+	pve := ev.(*types.PhantomValidatorEvidence)
+	return &phantomValidatorEvidenceHandler{votingPowerPriority{pve}, pve}
+}
+
+func (h *phantomValidatorEvidenceHandler) Verify(state sm.State, valSet *types.ValidatorSet, header *types.Header) error {
+	return sm.VerifyEvidence(state, h.ev, valSet, header)
+}
+
+func (h *phantomValidatorEvidenceHandler) Split(
+	_ *types.Header, _ *types.ValidatorSet, _ valToLastHeightMap,
+) []types.Evidence {
+	return []types.Evidence{h.ev}
+}
+
+type conflictingHeadersEvidenceHandler struct {
+	votingPowerPriority
+	ev *types.ConflictingHeadersEvidence
+}
+
+func newConflictingHeadersEvidenceHandler(ev types.Evidence) EvidenceHandler {
+	che := ev.(*types.ConflictingHeadersEvidence)
+	return &conflictingHeadersEvidenceHandler{votingPowerPriority{che}, che}
+}
+
+func (h *conflictingHeadersEvidenceHandler) Verify(_ sm.State, valSet *types.ValidatorSet, header *types.Header) error {
+	return h.ev.VerifyComposite(header, valSet)
+}
+
+func (h *conflictingHeadersEvidenceHandler) Split(
+	header *types.Header, valSet *types.ValidatorSet, valToLastHeight valToLastHeightMap,
+) []types.Evidence {
+	return h.ev.Split(header, valSet, valToLastHeight)
+}
+
+type amnesiaEvidenceHandler struct {
+	ev *types.AmnesiaEvidence
+}
+
+func newAmnesiaEvidenceHandler(ev types.Evidence) EvidenceHandler {
+	return &amnesiaEvidenceHandler{ev.(*types.AmnesiaEvidence)}
+}
+
+func (h *amnesiaEvidenceHandler) Verify(state sm.State, valSet *types.ValidatorSet, header *types.Header) error {
+	return sm.VerifyEvidence(state, h.ev, valSet, header)
+}
+
+// Priority weights amnesia evidence by the accused validator's voting power
+// plus the height at which it was observed, so that of two accusations
+// against validators with equal power the more recent one (the higher
+// height) gets the higher priority.
+func (h *amnesiaEvidenceHandler) Priority(valSet *types.ValidatorSet) int64 {
+	_, val := valSet.GetByAddress(h.ev.Address())
+	return val.VotingPower + h.ev.Height()
+}
+
+func (h *amnesiaEvidenceHandler) Split(
+	_ *types.Header, _ *types.ValidatorSet, _ valToLastHeightMap,
+) []types.Evidence {
+	return []types.Evidence{h.ev}
+}
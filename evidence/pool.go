@@ -34,6 +34,11 @@ type Pool struct {
 	// latest state
 	mtx   sync.Mutex
 	state sm.State
+
+	// potentialMtx serializes reads and writes to the potential-evidence
+	// (amnesia trial) bucket, so Update's end-of-block promotion scan can't
+	// race with AddEvidence opening or clearing a trial.
+	potentialMtx sync.Mutex
 }
 
 // Validator.Address -> Last height it was in validator set
@@ -67,6 +72,10 @@ func NewPool(stateDB, evidenceDB dbm.DB, blockStore *store.BlockStore) *Pool {
 		pool.evidenceList.PushBack(ev)
 	}
 
+	// Amnesia evidence whose trial period already ran its course while this
+	// node was offline shouldn't wait for the next block to be promoted.
+	pool.promoteExpiredPotentialEvidence(state.LastBlockHeight)
+
 	return pool
 }
 
@@ -117,11 +126,19 @@ func (evpool *Pool) Update(block *types.Block, state sm.State) {
 	evpool.MarkEvidenceAsCommitted(block.Height, block.Time, block.Evidence.Evidence)
 
 	evpool.cleanupValToLastHeight(block.Height)
+
+	// Promote any potential amnesia evidence whose trial period has expired
+	// without a POLC clearing the accused validator. Runs synchronously,
+	// serialized against AddEvidence by potentialMtx, so Update never races
+	// a trial being opened or cleared concurrently.
+	evpool.promoteExpiredPotentialEvidence(block.Height)
 }
 
-// AddEvidence checks the evidence is valid and adds it to the pool. If
-// evidence is composite (ConflictingHeadersEvidence), it will be broken up
-// into smaller pieces.
+// AddEvidence checks the evidence is valid and adds it to the pool.
+// Verification, prioritization and (for composite evidence such as
+// ConflictingHeadersEvidence) splitting are delegated to the
+// EvidenceHandler registered for the evidence's concrete type; see
+// RegisterEvidenceHandler.
 func (evpool *Pool) AddEvidence(evidence types.Evidence) error {
 	var (
 		state  = evpool.State()
@@ -133,8 +150,13 @@ func (evpool *Pool) AddEvidence(evidence types.Evidence) error {
 		return fmt.Errorf("can't load validators at height #%d: %w", evidence.Height(), err)
 	}
 
+	handler, err := evidenceHandlerFor(evidence)
+	if err != nil {
+		return err
+	}
+
 	// Break composite evidence into smaller pieces.
-	if ce, ok := evidence.(types.CompositeEvidence); ok {
+	if _, ok := evidence.(types.CompositeEvidence); ok {
 		evpool.logger.Info("Breaking up composite evidence", "ev", evidence)
 
 		blockMeta := evpool.blockStore.LoadBlockMeta(evidence.Height())
@@ -142,11 +164,11 @@ func (evpool *Pool) AddEvidence(evidence types.Evidence) error {
 			return fmt.Errorf("don't have block meta at height #%d", evidence.Height())
 		}
 
-		if err := ce.VerifyComposite(&blockMeta.Header, valSet); err != nil {
+		if err := handler.Verify(state, valSet, &blockMeta.Header); err != nil {
 			return err
 		}
 
-		evList = ce.Split(&blockMeta.Header, valSet, evpool.valToLastHeight)
+		evList = handler.Split(&blockMeta.Header, valSet, evpool.valToLastHeight)
 	}
 
 	for _, ev := range evList {
@@ -158,6 +180,11 @@ func (evpool *Pool) AddEvidence(evidence types.Evidence) error {
 			return ErrEvidenceAlreadyStored{}
 		}
 
+		handler, err := evidenceHandlerFor(ev)
+		if err != nil {
+			return err
+		}
+
 		// For lunatic validator evidence, a header needs to be fetched.
 		var header *types.Header
 		if _, ok := ev.(*types.LunaticValidatorEvidence); ok {
@@ -169,13 +196,48 @@ func (evpool *Pool) AddEvidence(evidence types.Evidence) error {
 		}
 
 		// 1) Verify against state.
-		if err := sm.VerifyEvidence(evpool.stateDB, state, ev, header); err != nil {
+		if err := handler.Verify(state, valSet, header); err != nil {
 			return fmt.Errorf("failed to verify %v: %w", ev, err)
 		}
 
+		// AmnesiaEvidence without an accompanying POLC isn't committable
+		// yet: the accused validator gets a trial period (ConsensusParams.
+		// Evidence.ProofTrialPeriod) to submit one before we believe it. Park
+		// it in the potential-evidence bucket instead of gossiping it.
+		if ae, ok := ev.(*types.AmnesiaEvidence); ok && isPendingAmnesiaTrial(ae) {
+			evpool.potentialMtx.Lock()
+			err := evpool.store.addPotentialEvidence(ae)
+			evpool.potentialMtx.Unlock()
+			if err != nil {
+				return ErrDatabase{err}
+			}
+			evpool.logger.Info("Received potential amnesia evidence; opening trial period", "ev", ae)
+			continue
+		}
+
+		// A validator accused of amnesia that comes with a POLC is only
+		// cleared once that POLC actually proves 2/3+ of the voting power
+		// had prevoted for the block it locked onto -- an empty or
+		// under-signed POLC must not clear the accusation. The trial stays
+		// open so the accused validator can still submit a valid one before
+		// it expires.
+		if ae, ok := ev.(*types.AmnesiaEvidence); ok && ae.Polc != nil {
+			if err := ae.Polc.ValidateVotes(valSet); err != nil {
+				return fmt.Errorf("invalid POLC submitted for %v: %w", ae, err)
+			}
+
+			evpool.potentialMtx.Lock()
+			err := evpool.store.removePotentialEvidence(ae)
+			evpool.potentialMtx.Unlock()
+			if err != nil {
+				return ErrDatabase{err}
+			}
+			evpool.logger.Info("Valid POLC submitted; clearing amnesia accusation", "ev", ae)
+			continue
+		}
+
 		// 2) Compute priority.
-		_, val := valSet.GetByAddress(ev.Address())
-		priority := val.VotingPower
+		priority := handler.Priority(valSet)
 
 		// 3) Save to store.
 		err = evpool.store.addEvidence(ev, priority)
@@ -215,14 +277,15 @@ func (evpool *Pool) removeEvidence(
 
 	for e := evpool.evidenceList.Front(); e != nil; e = e.Next() {
 		var (
-			ev           = e.Value.(types.Evidence)
-			ageDuration  = lastBlockTime.Sub(ev.Time())
-			ageNumBlocks = height - ev.Height()
+			ev              = e.Value.(types.Evidence)
+			ageDuration     = lastBlockTime.Sub(ev.Time())
+			ageNumBlocks    = height - ev.Height()
+			maxAgeNumBlocks = effectiveMaxAgeNumBlocks(params, ev)
 		)
 
 		// Remove the evidence if it's already in a block or if it's now too old.
 		if _, ok := blockEvidenceMap[evMapKey(ev)]; ok ||
-			(ageDuration > params.MaxAgeDuration && ageNumBlocks > params.MaxAgeNumBlocks) {
+			(ageDuration > params.MaxAgeDuration && ageNumBlocks > maxAgeNumBlocks) {
 			// remove from clist
 			evpool.evidenceList.Remove(e)
 			e.DetachPrev()
@@ -231,7 +294,14 @@ func (evpool *Pool) removeEvidence(
 }
 
 func (evpool *Pool) cleanupValToLastHeight(blockHeight int64) {
-	removeHeight := blockHeight - evpool.State().ConsensusParams.Evidence.MaxAgeNumBlocks
+	maxAgeNumBlocks := evpool.State().ConsensusParams.Evidence.MaxAgeNumBlocks
+	if evpool.hasOpenAmnesiaTrials() {
+		// Keep validator bonding history around for an extra
+		// ProofTrialPeriod blocks while any amnesia accusation is on trial.
+		maxAgeNumBlocks += evpool.State().ConsensusParams.Evidence.ProofTrialPeriod
+	}
+
+	removeHeight := blockHeight - maxAgeNumBlocks
 	if removeHeight >= 1 {
 		valSet, err := sm.LoadValidators(evpool.stateDB, removeHeight)
 		if err != nil {
@@ -247,14 +317,96 @@ func (evpool *Pool) cleanupValToLastHeight(blockHeight int64) {
 
 func (evpool *Pool) IsExpired(evidence types.Evidence) bool {
 	var (
-		params       = evpool.State().ConsensusParams.Evidence
-		ageDuration  = evpool.State().LastBlockTime.Sub(evidence.Time())
-		ageNumBlocks = evpool.State().LastBlockHeight - evidence.Height()
+		params          = evpool.State().ConsensusParams.Evidence
+		ageDuration     = evpool.State().LastBlockTime.Sub(evidence.Time())
+		ageNumBlocks    = evpool.State().LastBlockHeight - evidence.Height()
+		maxAgeNumBlocks = effectiveMaxAgeNumBlocks(params, evidence)
 	)
-	return ageNumBlocks > params.MaxAgeNumBlocks &&
+
+	return ageNumBlocks > maxAgeNumBlocks &&
 		ageDuration > params.MaxAgeDuration
 }
 
+// isPendingAmnesiaTrial reports whether ev is AmnesiaEvidence with no POLC
+// attached. That holds both for an accusation still within its
+// accountability trial period and for one already promoted to committable
+// after its trial expired; the two are not told apart.
+func isPendingAmnesiaTrial(ev types.Evidence) bool {
+	ae, ok := ev.(*types.AmnesiaEvidence)
+	return ok && ae.Polc == nil
+}
+
+// effectiveMaxAgeNumBlocks returns the MaxAgeNumBlocks threshold evidence
+// should be aged against. AmnesiaEvidence without a POLC (see
+// isPendingAmnesiaTrial) gets ProofTrialPeriod added on top, so the blocks
+// spent on trial don't count against its MaxAgeNumBlocks.
+func effectiveMaxAgeNumBlocks(params types.EvidenceParams, ev types.Evidence) int64 {
+	if isPendingAmnesiaTrial(ev) {
+		return params.MaxAgeNumBlocks + params.ProofTrialPeriod
+	}
+	return params.MaxAgeNumBlocks
+}
+
+// trialExpired reports whether AmnesiaEvidence observed at evidenceHeight
+// has run out its trialPeriod as of currentHeight.
+func trialExpired(currentHeight, evidenceHeight, trialPeriod int64) bool {
+	return currentHeight-evidenceHeight >= trialPeriod
+}
+
+// hasOpenAmnesiaTrials reports whether any amnesia evidence is currently
+// sitting in the potential-evidence bucket awaiting a POLC.
+func (evpool *Pool) hasOpenAmnesiaTrials() bool {
+	evpool.potentialMtx.Lock()
+	defer evpool.potentialMtx.Unlock()
+	return len(evpool.store.listEvidence(baseKeyPotential, -1)) > 0
+}
+
+// promoteExpiredPotentialEvidence scans the potential-evidence bucket for
+// AmnesiaEvidence whose trial period (ConsensusParams.Evidence.
+// ProofTrialPeriod) has elapsed without a valid POLC being submitted, and
+// promotes it to committable evidence: it moves out of baseKeyPotential and
+// into baseKeyPending, and is pushed onto evidenceList for gossip and
+// inclusion in blocks. Access to the potential-evidence bucket is
+// serialized by potentialMtx so this can't race a concurrent AddEvidence
+// opening or clearing a trial.
+func (evpool *Pool) promoteExpiredPotentialEvidence(height int64) {
+	trialPeriod := evpool.State().ConsensusParams.Evidence.ProofTrialPeriod
+
+	evpool.potentialMtx.Lock()
+	defer evpool.potentialMtx.Unlock()
+
+	for _, ev := range evpool.store.listEvidence(baseKeyPotential, -1) {
+		ae, ok := ev.(*types.AmnesiaEvidence)
+		if !ok || !isPendingAmnesiaTrial(ae) {
+			continue
+		}
+
+		if !trialExpired(height, ae.Height(), trialPeriod) {
+			continue // trial period still open
+		}
+
+		valSet, err := sm.LoadValidators(evpool.stateDB, ae.Height())
+		if err != nil {
+			evpool.logger.Error("Failed to load validators to promote potential evidence", "ev", ae, "err", err)
+			continue
+		}
+
+		handler, err := evidenceHandlerFor(ae)
+		if err != nil {
+			evpool.logger.Error("Failed to find an EvidenceHandler to promote potential evidence", "ev", ae, "err", err)
+			continue
+		}
+
+		if err := evpool.store.promotePotentialEvidence(ae, handler.Priority(valSet)); err != nil {
+			evpool.logger.Error("Failed to promote potential evidence", "ev", ae, "err", err)
+			continue
+		}
+
+		evpool.evidenceList.PushBack(ae)
+		evpool.logger.Info("Amnesia evidence trial period expired without POLC; promoting to committable", "ev", ae)
+	}
+}
+
 func evMapKey(ev types.Evidence) string {
 	return string(ev.Hash())
 }
@@ -0,0 +1,188 @@
+package evidence
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	clist "github.com/tendermint/tendermint/libs/clist"
+	"github.com/tendermint/tendermint/libs/log"
+	sm "github.com/tendermint/tendermint/state"
+	"github.com/tendermint/tendermint/types"
+)
+
+// newTestPool builds a Pool around an in-memory evidence store, bypassing
+// NewPool's pending-evidence reload and blockStore requirement -- tests
+// below only exercise AmnesiaEvidence, which never touches blockStore.
+func newTestPool(stateDB dbm.DB, state sm.State) *Pool {
+	return &Pool{
+		stateDB:      stateDB,
+		state:        state,
+		logger:       log.NewNopLogger(),
+		store:        NewStore(dbm.NewMemDB()),
+		evidenceList: clist.New(),
+	}
+}
+
+func TestEffectiveMaxAgeNumBlocks(t *testing.T) {
+	params := types.EvidenceParams{
+		MaxAgeNumBlocks:  100,
+		MaxAgeDuration:   time.Hour,
+		ProofTrialPeriod: 20,
+	}
+
+	duplicateVote := &types.DuplicateVoteEvidence{}
+
+	amnesiaOnTrial := &types.AmnesiaEvidence{}
+	amnesiaCleared := &types.AmnesiaEvidence{Polc: &types.POLC{}}
+
+	testCases := []struct {
+		name     string
+		ev       types.Evidence
+		expected int64
+	}{
+		{"evidence kind unaffected by the trial period", duplicateVote, 100},
+		{"amnesia evidence still awaiting a POLC gets the trial period added", amnesiaOnTrial, 120},
+		{"amnesia evidence cleared by a POLC is aged normally", amnesiaCleared, 100},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, effectiveMaxAgeNumBlocks(params, tc.ev))
+		})
+	}
+}
+
+func TestIsPendingAmnesiaTrial(t *testing.T) {
+	assert.True(t, isPendingAmnesiaTrial(&types.AmnesiaEvidence{}))
+	assert.False(t, isPendingAmnesiaTrial(&types.AmnesiaEvidence{Polc: &types.POLC{}}))
+	assert.False(t, isPendingAmnesiaTrial(&types.DuplicateVoteEvidence{}))
+}
+
+func TestTrialExpired(t *testing.T) {
+	testCases := []struct {
+		name           string
+		currentHeight  int64
+		evidenceHeight int64
+		trialPeriod    int64
+		expired        bool
+	}{
+		{"trial period still open", 10, 1, 20, false},
+		{"one block short of the trial period", 20, 1, 20, false},
+		{"exactly at the trial period boundary", 21, 1, 20, true},
+		{"well past the trial period", 100, 1, 20, true},
+		{"zero-length trial period expires immediately", 1, 1, 0, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expired, trialExpired(tc.currentHeight, tc.evidenceHeight, tc.trialPeriod))
+		})
+	}
+}
+
+func TestPoolPromotesAmnesiaEvidenceOnceTrialExpires(t *testing.T) {
+	stateDB := dbm.NewMemDB()
+	valAddr := []byte("accused-validator-01")
+	valSet := &types.ValidatorSet{Validators: []*types.Validator{{Address: valAddr, VotingPower: 10}}}
+	require.NoError(t, sm.SaveValidators(stateDB, 1, valSet))
+
+	state := sm.State{ConsensusParams: types.ConsensusParams{Evidence: types.EvidenceParams{ProofTrialPeriod: 5}}}
+	pool := newTestPool(stateDB, state)
+
+	ae := &types.AmnesiaEvidence{
+		VoteA: types.Vote{Height: 1, Round: 0, ValidatorAddress: valAddr},
+		VoteB: types.Vote{Height: 1, Round: 1, ValidatorAddress: valAddr},
+	}
+	require.NoError(t, pool.store.addPotentialEvidence(ae))
+
+	// trial period still open at height 5 (1 + 5 == 6): nothing promoted yet
+	pool.promoteExpiredPotentialEvidence(5)
+	assert.Empty(t, pool.store.PendingEvidence(-1))
+	assert.Len(t, pool.store.listEvidence(baseKeyPotential, -1), 1)
+
+	// trial period has now elapsed
+	pool.promoteExpiredPotentialEvidence(6)
+	pending := pool.store.PendingEvidence(-1)
+	require.Len(t, pending, 1)
+	assert.True(t, pending[0].Equal(ae))
+	assert.Empty(t, pool.store.listEvidence(baseKeyPotential, -1))
+}
+
+// amnesiaEvidenceFixture wires up a Pool with a single validator and an
+// AmnesiaEvidence accusation (voting for blockA in round 0, then blockB in
+// round 1) signed with a real key, so AddEvidence's signature check in
+// sm.VerifyEvidence passes. Tests attach their own Polc before submitting it.
+type amnesiaEvidenceFixture struct {
+	pool   *Pool
+	ae     *types.AmnesiaEvidence
+	blockB types.BlockID
+}
+
+func newAmnesiaEvidenceFixture(t *testing.T) amnesiaEvidenceFixture {
+	t.Helper()
+
+	const chainID = "test-chain"
+
+	stateDB := dbm.NewMemDB()
+	privKey := ed25519.GenPrivKey()
+	valAddr := []byte("accused-validator-02")
+	valSet := &types.ValidatorSet{
+		Validators: []*types.Validator{{Address: valAddr, PubKey: privKey.PubKey(), VotingPower: 10}},
+	}
+	require.NoError(t, sm.SaveValidators(stateDB, 1, valSet))
+
+	state := sm.State{ChainID: chainID, ConsensusParams: types.ConsensusParams{Evidence: types.EvidenceParams{ProofTrialPeriod: 5}}}
+	pool := newTestPool(stateDB, state)
+
+	blockA := types.BlockID{Hash: []byte("block-A")}
+	blockB := types.BlockID{Hash: []byte("block-B")}
+
+	signVote := func(round int, blockID types.BlockID) types.Vote {
+		vote := types.Vote{Height: 1, Round: round, Timestamp: time.Now(), ValidatorAddress: valAddr, BlockID: blockID}
+		sig, err := privKey.Sign(vote.SignBytes(chainID))
+		require.NoError(t, err)
+		vote.Signature = sig
+		return vote
+	}
+
+	ae := &types.AmnesiaEvidence{VoteA: signVote(0, blockA), VoteB: signVote(1, blockB)}
+
+	return amnesiaEvidenceFixture{pool: pool, ae: ae, blockB: blockB}
+}
+
+func TestPoolAddEvidenceClearsAccusationWithValidPOLC(t *testing.T) {
+	f := newAmnesiaEvidenceFixture(t)
+
+	// The trial is opened for the accusation as the pool stores it: without a Polc.
+	require.NoError(t, f.pool.store.addPotentialEvidence(f.ae))
+
+	// A POLC with a vote for blockB carrying the accused validator's full
+	// voting power clears 2/3+ of the (single-validator) set.
+	f.ae.Polc = &types.POLC{
+		Height: 1,
+		Block:  f.blockB,
+		Votes:  []types.Vote{{Height: 1, Round: 1, ValidatorAddress: f.ae.VoteB.ValidatorAddress, BlockID: f.blockB}},
+	}
+
+	err := f.pool.AddEvidence(f.ae)
+	assert.NoError(t, err)
+	assert.Empty(t, f.pool.store.listEvidence(baseKeyPotential, -1), "a valid POLC must clear the open trial")
+	assert.Empty(t, f.pool.store.PendingEvidence(-1), "a cleared accusation must not become committable")
+}
+
+func TestPoolAddEvidenceRejectsEmptyPOLC(t *testing.T) {
+	f := newAmnesiaEvidenceFixture(t)
+
+	f.ae.Polc = &types.POLC{Height: 1, Block: f.blockB} // no votes
+	require.NoError(t, f.pool.store.addPotentialEvidence(f.ae))
+
+	err := f.pool.AddEvidence(f.ae)
+	assert.Error(t, err, "an empty, zero-vote POLC must not clear an amnesia accusation")
+	assert.Len(t, f.pool.store.listEvidence(baseKeyPotential, -1), 1, "the trial must stay open")
+}
@@ -0,0 +1,147 @@
+package evidence
+
+import (
+	"fmt"
+
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/tendermint/tendermint/types"
+)
+
+const (
+	baseKeyCommitted = "committed"
+	baseKeyPending   = "pending"
+
+	// baseKeyPotential holds AmnesiaEvidence whose accountability trial
+	// period (ConsensusParams.Evidence.ProofTrialPeriod) hasn't yet run its
+	// course: the accused validator may still clear itself with a POLC.
+	// Evidence here is neither gossiped nor eligible for block inclusion
+	// until Pool promotes it into baseKeyPending.
+	baseKeyPotential = "potential"
+)
+
+func keyCommitted(evidence types.Evidence) []byte {
+	return _key(baseKeyCommitted, evidence)
+}
+
+func keyPending(evidence types.Evidence) []byte {
+	return _key(baseKeyPending, evidence)
+}
+
+func keyPotential(evidence types.Evidence) []byte {
+	return _key(baseKeyPotential, evidence)
+}
+
+func _key(prefix string, evidence types.Evidence) []byte {
+	return []byte(fmt.Sprintf("%s/%020d/%X", prefix, evidence.Height(), evidence.Hash()))
+}
+
+// Info bundles evidence together with the priority it was stored under.
+type Info struct {
+	Evidence types.Evidence
+	Priority int64
+}
+
+// Store is a store of evidence, keyed by whether it is pending (verified
+// and committable), potential (an AmnesiaEvidence accusation still on
+// trial) or already committed.
+type Store struct {
+	db dbm.DB
+}
+
+func NewStore(db dbm.DB) *Store {
+	return &Store{db: db}
+}
+
+// Has returns true if the evidence is already known as pending evidence.
+func (store *Store) Has(evidence types.Evidence) (bool, error) {
+	return store.db.Has(keyPending(evidence))
+}
+
+// addEvidence stores ev as pending, committable evidence under the given
+// priority.
+func (store *Store) addEvidence(evidence types.Evidence, priority int64) error {
+	bz, err := cdc.MarshalBinaryBare(Info{Evidence: evidence, Priority: priority})
+	if err != nil {
+		return fmt.Errorf("unable to marshal evidence: %w", err)
+	}
+	return store.db.Set(keyPending(evidence), bz)
+}
+
+// addPotentialEvidence stores AmnesiaEvidence that hasn't yet cleared its
+// accountability trial period. It is kept separate from baseKeyPending so
+// PendingEvidence/listEvidence(baseKeyPending, ...) don't surface it for
+// gossip or block inclusion before Pool promotes it.
+func (store *Store) addPotentialEvidence(evidence *types.AmnesiaEvidence) error {
+	bz, err := cdc.MarshalBinaryBare(Info{Evidence: evidence})
+	if err != nil {
+		return fmt.Errorf("unable to marshal potential evidence: %w", err)
+	}
+	return store.db.Set(keyPotential(evidence), bz)
+}
+
+// removePotentialEvidence deletes evidence from the potential bucket, e.g.
+// once a valid POLC has cleared the accused validator. The accusation is
+// stored without a Polc, so it is looked up by its votes alone.
+func (store *Store) removePotentialEvidence(evidence *types.AmnesiaEvidence) error {
+	accusation := &types.AmnesiaEvidence{VoteA: evidence.VoteA, VoteB: evidence.VoteB}
+	return store.db.Delete(keyPotential(accusation))
+}
+
+// promotePotentialEvidence moves evidence out of the potential bucket and
+// into the pending bucket under the given priority: its trial period has
+// expired without a POLC, so it is now committable.
+func (store *Store) promotePotentialEvidence(evidence *types.AmnesiaEvidence, priority int64) error {
+	if err := store.addEvidence(evidence, priority); err != nil {
+		return err
+	}
+	return store.db.Delete(keyPotential(evidence))
+}
+
+// MarkEvidenceAsCommitted moves evidence from pending to committed.
+func (store *Store) MarkEvidenceAsCommitted(evidence types.Evidence) {
+	if err := store.db.Delete(keyPending(evidence)); err != nil {
+		panic(err)
+	}
+
+	bz, err := cdc.MarshalBinaryBare(Info{Evidence: evidence})
+	if err != nil {
+		panic(err)
+	}
+	if err := store.db.Set(keyCommitted(evidence), bz); err != nil {
+		panic(err)
+	}
+}
+
+// listEvidence lists evidence stored under the given prefix bucket
+// (baseKeyPending, baseKeyPotential or baseKeyCommitted). If maxNum is -1,
+// all matching evidence is returned.
+func (store *Store) listEvidence(prefixKey string, maxNum int64) []types.Evidence {
+	var evidence []types.Evidence
+
+	iter, err := dbm.IteratePrefix(store.db, []byte(prefixKey))
+	if err != nil {
+		return evidence
+	}
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		if maxNum != -1 && int64(len(evidence)) >= maxNum {
+			break
+		}
+
+		var info Info
+		if err := cdc.UnmarshalBinaryBare(iter.Value(), &info); err != nil {
+			continue
+		}
+		evidence = append(evidence, info.Evidence)
+	}
+
+	return evidence
+}
+
+// PendingEvidence returns up to maxNum uncommitted evidence. If maxNum is
+// -1, all pending evidence is returned.
+func (store *Store) PendingEvidence(maxNum int64) []types.Evidence {
+	return store.listEvidence(baseKeyPending, maxNum)
+}
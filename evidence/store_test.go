@@ -0,0 +1,57 @@
+package evidence
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/tendermint/tendermint/types"
+)
+
+func newTestStore() *Store {
+	return NewStore(dbm.NewMemDB())
+}
+
+func TestStorePotentialEvidence(t *testing.T) {
+	store := newTestStore()
+	ae := &types.AmnesiaEvidence{VoteA: types.Vote{Height: 10, Round: 0}, VoteB: types.Vote{Height: 10, Round: 1}}
+
+	assert.Empty(t, store.listEvidence(baseKeyPotential, -1), "nothing on trial yet")
+
+	require.NoError(t, store.addPotentialEvidence(ae))
+
+	potential := store.listEvidence(baseKeyPotential, -1)
+	require.Len(t, potential, 1)
+	assert.True(t, potential[0].Equal(ae))
+
+	// adding it again should just overwrite the same key, not duplicate it
+	require.NoError(t, store.addPotentialEvidence(ae))
+	assert.Len(t, store.listEvidence(baseKeyPotential, -1), 1)
+}
+
+func TestStoreRemovePotentialEvidence(t *testing.T) {
+	store := newTestStore()
+	ae := &types.AmnesiaEvidence{VoteA: types.Vote{Height: 10, Round: 0}, VoteB: types.Vote{Height: 10, Round: 1}}
+
+	require.NoError(t, store.addPotentialEvidence(ae))
+	require.NoError(t, store.removePotentialEvidence(ae))
+
+	assert.Empty(t, store.listEvidence(baseKeyPotential, -1))
+}
+
+func TestStorePromotePotentialEvidence(t *testing.T) {
+	store := newTestStore()
+	ae := &types.AmnesiaEvidence{VoteA: types.Vote{Height: 10, Round: 0}, VoteB: types.Vote{Height: 10, Round: 1}}
+
+	require.NoError(t, store.addPotentialEvidence(ae))
+	require.NoError(t, store.promotePotentialEvidence(ae, 42))
+
+	assert.Empty(t, store.listEvidence(baseKeyPotential, -1), "promoted evidence must leave the potential bucket")
+
+	pending := store.PendingEvidence(-1)
+	require.Len(t, pending, 1)
+	assert.True(t, pending[0].Equal(ae))
+}
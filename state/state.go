@@ -0,0 +1,20 @@
+package state
+
+import (
+	"time"
+
+	"github.com/tendermint/tendermint/types"
+)
+
+// State is the latest consensus-critical state tracked by the node: the
+// current consensus parameters and the tip of the chain. The evidence Pool
+// uses it to look up the trial period and aging thresholds that govern
+// which evidence it holds and for how long.
+type State struct {
+	ChainID string
+
+	LastBlockHeight int64
+	LastBlockTime   time.Time
+
+	ConsensusParams types.ConsensusParams
+}
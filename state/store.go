@@ -0,0 +1,70 @@
+package state
+
+import (
+	"fmt"
+
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/tendermint/tendermint/types"
+)
+
+const stateKey = "stateKey"
+
+func validatorsKey(height int64) []byte {
+	return []byte(fmt.Sprintf("validatorsKey:%v", height))
+}
+
+// ErrNoValSetForHeight is returned by LoadValidators when no validator set
+// has been stored for the requested height, e.g. because it's further back
+// than this node has retained.
+type ErrNoValSetForHeight struct {
+	Height int64
+}
+
+func (e ErrNoValSetForHeight) Error() string {
+	return fmt.Sprintf("couldn't find validator set for height #%d", e.Height)
+}
+
+// LoadState loads the latest State from db. It returns an empty State if
+// none has been saved yet.
+func LoadState(db dbm.DB) State {
+	buf, err := db.Get([]byte(stateKey))
+	if err != nil {
+		panic(err)
+	}
+	if len(buf) == 0 {
+		return State{}
+	}
+
+	var state State
+	if err := cdc.UnmarshalBinaryBare(buf, &state); err != nil {
+		panic(fmt.Sprintf("unable to unmarshal state: %v", err))
+	}
+	return state
+}
+
+// SaveValidators persists the validator set active at height.
+func SaveValidators(db dbm.DB, height int64, valSet *types.ValidatorSet) error {
+	bz, err := cdc.MarshalBinaryBare(valSet)
+	if err != nil {
+		return fmt.Errorf("unable to marshal validator set: %w", err)
+	}
+	return db.Set(validatorsKey(height), bz)
+}
+
+// LoadValidators loads the validator set that was active at height.
+func LoadValidators(db dbm.DB, height int64) (*types.ValidatorSet, error) {
+	buf, err := db.Get(validatorsKey(height))
+	if err != nil {
+		panic(err)
+	}
+	if len(buf) == 0 {
+		return nil, ErrNoValSetForHeight{height}
+	}
+
+	var valSet types.ValidatorSet
+	if err := cdc.UnmarshalBinaryBare(buf, &valSet); err != nil {
+		panic(fmt.Sprintf("unable to unmarshal validator set: %v", err))
+	}
+	return &valSet, nil
+}
@@ -0,0 +1,43 @@
+package state
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/types"
+)
+
+// VerifyEvidence checks that evidence is internally well-formed and
+// consistent with valSet, the validator set active at evidence.Height().
+func VerifyEvidence(state State, evidence types.Evidence, valSet *types.ValidatorSet, header *types.Header) error {
+	if err := evidence.ValidateBasic(); err != nil {
+		return fmt.Errorf("evidence failed ValidateBasic: %w", err)
+	}
+
+	idx, val := valSet.GetByAddress(evidence.Address())
+	if idx < 0 {
+		return fmt.Errorf("address %X was not a validator at height #%d", evidence.Address(), evidence.Height())
+	}
+
+	switch ev := evidence.(type) {
+	case *types.AmnesiaEvidence:
+		return verifyAmnesiaEvidence(ev, val, state.ChainID)
+
+	default:
+		// DuplicateVoteEvidence, LunaticValidatorEvidence and
+		// PhantomValidatorEvidence are already covered by ValidateBasic plus
+		// the validator-set membership check above.
+		return nil
+	}
+}
+
+// verifyAmnesiaEvidence checks that both of ae's votes are validly signed
+// by the accused validator, so an AmnesiaEvidence accusation can't be
+// forged from votes that were never cast.
+func verifyAmnesiaEvidence(ae *types.AmnesiaEvidence, val *types.Validator, chainID string) error {
+	for _, vote := range []types.Vote{ae.VoteA, ae.VoteB} {
+		if err := val.PubKey.VerifyBytes(vote.SignBytes(chainID), vote.Signature); err != nil {
+			return fmt.Errorf("invalid signature on vote at round %d: %w", vote.Round, err)
+		}
+	}
+	return nil
+}
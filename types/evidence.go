@@ -0,0 +1,118 @@
+package types
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	"github.com/tendermint/tendermint/crypto/tmhash"
+)
+
+// POLC (proof of lock change) is the evidence a validator accused by an
+// AmnesiaEvidence can present to clear itself: proof that more than 2/3 of
+// the voting power at the reference height had already prevoted for the
+// block it locked onto, justifying the lock change instead of an
+// equivocation.
+type POLC struct {
+	Height int64
+	Round  int
+	Block  BlockID
+	Votes  []Vote
+}
+
+// ValidateVotes checks that the POLC carries votes from more than 2/3 of
+// valSet's total voting power, each cast by a distinct member of valSet for
+// Block. The votes' signatures and type are not checked, nor are their
+// height and round compared with the POLC's Height and Round.
+func (polc *POLC) ValidateVotes(valSet *ValidatorSet) error {
+	if polc == nil {
+		return fmt.Errorf("empty POLC")
+	}
+
+	talliedVotingPower := int64(0)
+	seen := make(map[string]bool, len(polc.Votes))
+	for i, vote := range polc.Votes {
+		idx, val := valSet.GetByAddress(vote.ValidatorAddress)
+		if idx < 0 {
+			return fmt.Errorf("vote %d in POLC is not from a validator in the set at height %d", i, polc.Height)
+		}
+		if !bytes.Equal(vote.BlockID.Hash, polc.Block.Hash) {
+			return fmt.Errorf("vote %d in POLC is for a different block than the one it claims to lock on", i)
+		}
+
+		addr := string(vote.ValidatorAddress)
+		if seen[addr] {
+			return fmt.Errorf("vote %d in POLC is a duplicate of an earlier vote from %X", i, vote.ValidatorAddress)
+		}
+		seen[addr] = true
+
+		talliedVotingPower += val.VotingPower
+	}
+
+	if needed := valSet.TotalVotingPower() * 2 / 3; talliedVotingPower <= needed {
+		return fmt.Errorf("insufficient voting power in POLC: got %d, need more than %d", talliedVotingPower, needed)
+	}
+
+	return nil
+}
+
+// AmnesiaEvidence accuses a validator of voting for conflicting blocks
+// across rounds at the same height without a POLC justifying the lock
+// change -- an "amnesia" attack on the light client. Polc is nil while the
+// accusation is within its accountability trial period
+// (ConsensusParams.Evidence.ProofTrialPeriod); the evidence.Pool holds it
+// as potential evidence during that window. A valid Polc submitted before
+// the trial expires clears the validator instead of the evidence being
+// promoted to committable.
+type AmnesiaEvidence struct {
+	VoteA Vote
+	VoteB Vote
+	Polc  *POLC
+}
+
+func (e *AmnesiaEvidence) Height() int64 { return e.VoteA.Height }
+
+func (e *AmnesiaEvidence) Time() time.Time { return e.VoteB.Timestamp }
+
+func (e *AmnesiaEvidence) Address() []byte { return e.VoteA.ValidatorAddress }
+
+func (e *AmnesiaEvidence) Bytes() []byte {
+	return cdc.MustMarshalBinaryBare(e)
+}
+
+func (e *AmnesiaEvidence) Hash() []byte {
+	return tmhash.Sum(e.Bytes())
+}
+
+func (e *AmnesiaEvidence) String() string {
+	return fmt.Sprintf("AmnesiaEvidence{%X voted in both round %d and %d at height %d}",
+		e.VoteA.ValidatorAddress, e.VoteA.Round, e.VoteB.Round, e.Height())
+}
+
+func (e *AmnesiaEvidence) ValidateBasic() error {
+	if e.VoteA.Height != e.VoteB.Height {
+		return fmt.Errorf("votes must be for the same height, got %d and %d", e.VoteA.Height, e.VoteB.Height)
+	}
+	if e.VoteA.Round == e.VoteB.Round {
+		return fmt.Errorf("votes must be from different rounds, both got round %d", e.VoteA.Round)
+	}
+	if !bytes.Equal(e.VoteA.ValidatorAddress, e.VoteB.ValidatorAddress) {
+		return fmt.Errorf("votes must come from the same validator")
+	}
+	if len(e.VoteA.BlockID.Hash) == 0 || len(e.VoteB.BlockID.Hash) == 0 {
+		return fmt.Errorf("votes must both be for a block, not a nil vote")
+	}
+	if bytes.Equal(e.VoteA.BlockID.Hash, e.VoteB.BlockID.Hash) {
+		return fmt.Errorf("votes must be for different blocks: voting twice for the same block " +
+			"across rounds is normal behaviour, not an amnesia attack")
+	}
+	return nil
+}
+
+func (e *AmnesiaEvidence) Equal(ev Evidence) bool {
+	other, ok := ev.(*AmnesiaEvidence)
+	if !ok {
+		return false
+	}
+	return bytes.Equal(e.Hash(), other.Hash())
+}
@@ -0,0 +1,23 @@
+package types
+
+import "time"
+
+// ConsensusParams groups the consensus-critical parameters a validator
+// needs to agree on with its peers.
+type ConsensusParams struct {
+	Evidence EvidenceParams
+}
+
+// EvidenceParams configure how the evidence pool ages evidence out of the
+// pending set, and, for amnesia accusations, how long the accused
+// validator has to clear themselves before the pool believes it.
+type EvidenceParams struct {
+	MaxAgeNumBlocks int64
+	MaxAgeDuration  time.Duration
+
+	// ProofTrialPeriod is the number of blocks an AmnesiaEvidence
+	// accusation is held as potential evidence, giving the accused
+	// validator a window to submit a POLC before the evidence pool
+	// promotes it to committable evidence.
+	ProofTrialPeriod int64
+}